@@ -0,0 +1,209 @@
+package binance
+
+import "context"
+
+// AccountService groups the account and position endpoints of the USD-M
+// futures API.
+type AccountService struct {
+	client *Client
+}
+
+// PositionRisk describes the current risk and PnL of one symbol's position.
+type PositionRisk struct {
+	Symbol           string `json:"symbol"`
+	PositionAmt      string `json:"positionAmt"`
+	EntryPrice       string `json:"entryPrice"`
+	MarkPrice        string `json:"markPrice"`
+	UnRealizedProfit string `json:"unRealizedProfit"`
+	LiquidationPrice string `json:"liquidationPrice"`
+	Leverage         string `json:"leverage"`
+	MarginType       string `json:"marginType"`
+	IsolatedMargin   string `json:"isolatedMargin"`
+	PositionSide     string `json:"positionSide"`
+}
+
+// GetPositionRiskRequest fetches position risk, optionally filtered to a
+// single symbol.
+type GetPositionRiskRequest struct {
+	client *Client
+	symbol string
+}
+
+func (s *AccountService) NewGetPositionRiskRequest() *GetPositionRiskRequest {
+	return &GetPositionRiskRequest{client: s.client}
+}
+
+func (r *GetPositionRiskRequest) Symbol(symbol string) *GetPositionRiskRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *GetPositionRiskRequest) Do(ctx context.Context) ([]*PositionRisk, error) {
+	opt := &struct {
+		Symbol string `url:"symbol,omitempty"`
+	}{Symbol: r.symbol}
+
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v2/positionRisk", opt, USER_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	var v []*PositionRisk
+	if _, err := r.client.Do(req, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// AccountBalance is one asset's entry in the futures wallet.
+type AccountBalance struct {
+	AccountAlias       string `json:"accountAlias"`
+	Asset              string `json:"asset"`
+	Balance            string `json:"balance"`
+	CrossWalletBalance string `json:"crossWalletBalance"`
+	AvailableBalance   string `json:"availableBalance"`
+}
+
+// GetAccountBalanceRequest fetches the futures wallet balance for every asset.
+type GetAccountBalanceRequest struct {
+	client *Client
+}
+
+func (s *AccountService) NewGetAccountBalanceRequest() *GetAccountBalanceRequest {
+	return &GetAccountBalanceRequest{client: s.client}
+}
+
+func (r *GetAccountBalanceRequest) Do(ctx context.Context) ([]*AccountBalance, error) {
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v2/balance", nil, USER_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	var v []*AccountBalance
+	if _, err := r.client.Do(req, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// AccountInformation is the futures account's overall status: fee tier,
+// trading permissions, asset balances and positions.
+type AccountInformation struct {
+	FeeTier  int  `json:"feeTier"`
+	CanTrade bool `json:"canTrade"`
+	Assets   []struct {
+		Asset            string `json:"asset"`
+		WalletBalance    string `json:"walletBalance"`
+		AvailableBalance string `json:"availableBalance"`
+	} `json:"assets"`
+	Positions []PositionRisk `json:"positions"`
+}
+
+// GetAccountInformationRequest fetches the account's overall status.
+type GetAccountInformationRequest struct {
+	client *Client
+}
+
+func (s *AccountService) NewGetAccountInformationRequest() *GetAccountInformationRequest {
+	return &GetAccountInformationRequest{client: s.client}
+}
+
+func (r *GetAccountInformationRequest) Do(ctx context.Context) (*AccountInformation, error) {
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v2/account", nil, USER_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(AccountInformation)
+	if _, err := r.client.Do(req, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// ChangeLeverageResponse confirms the leverage now in effect for a symbol.
+type ChangeLeverageResponse struct {
+	Leverage         int    `json:"leverage"`
+	MaxNotionalValue string `json:"maxNotionalValue"`
+	Symbol           string `json:"symbol"`
+}
+
+// ChangeLeverageRequest sets a symbol's initial leverage.
+type ChangeLeverageRequest struct {
+	client   *Client
+	symbol   string
+	leverage int
+}
+
+func (s *AccountService) NewChangeLeverageRequest() *ChangeLeverageRequest {
+	return &ChangeLeverageRequest{client: s.client}
+}
+
+func (r *ChangeLeverageRequest) Symbol(symbol string) *ChangeLeverageRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *ChangeLeverageRequest) Leverage(leverage int) *ChangeLeverageRequest {
+	r.leverage = leverage
+	return r
+}
+
+func (r *ChangeLeverageRequest) Do(ctx context.Context) (*ChangeLeverageResponse, error) {
+	opt := &struct {
+		Symbol   string `url:"symbol"`
+		Leverage int    `url:"leverage"`
+	}{Symbol: r.symbol, Leverage: r.leverage}
+
+	req, err := r.client.NewRequestWithContext(ctx, "POST", "/fapi/v1/leverage", opt, TRADE)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(ChangeLeverageResponse)
+	if _, err := r.client.Do(req, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// ChangeMarginTypeRequest switches a symbol between ISOLATED and CROSSED margin.
+type ChangeMarginTypeRequest struct {
+	client     *Client
+	symbol     string
+	marginType string
+}
+
+func (s *AccountService) NewChangeMarginTypeRequest() *ChangeMarginTypeRequest {
+	return &ChangeMarginTypeRequest{client: s.client}
+}
+
+func (r *ChangeMarginTypeRequest) Symbol(symbol string) *ChangeMarginTypeRequest {
+	r.symbol = symbol
+	return r
+}
+
+// MarginType must be "ISOLATED" or "CROSSED".
+func (r *ChangeMarginTypeRequest) MarginType(marginType string) *ChangeMarginTypeRequest {
+	r.marginType = marginType
+	return r
+}
+
+func (r *ChangeMarginTypeRequest) Do(ctx context.Context) error {
+	opt := &struct {
+		Symbol     string `url:"symbol"`
+		MarginType string `url:"marginType"`
+	}{Symbol: r.symbol, MarginType: r.marginType}
+
+	req, err := r.client.NewRequestWithContext(ctx, "POST", "/fapi/v1/marginType", opt, TRADE)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.Do(req, nil)
+	return err
+}
@@ -0,0 +1,153 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Candletick is one entry of Binance's /fapi/v1/klines response, decoded
+// from its positional JSON array form:
+//
+//	[
+//	  1499040000000,      // Open time
+//	  "0.01634790",       // Open
+//	  "0.80000000",       // High
+//	  "0.01575800",       // Low
+//	  "0.01577100",       // Close
+//	  "148976.11427815",  // Volume
+//	  1499644799999,      // Close time
+//	  "2434.19055334",    // Quote asset volume
+//	  308,                // Number of trades
+//	  "1756.87402397",    // Taker buy base asset volume
+//	  "28.46694368",      // Taker buy quote asset volume
+//	  "0"                 // Ignore
+//	]
+type Candletick struct {
+	OpenTime            time.Time
+	Open                decimal.Decimal
+	High                decimal.Decimal
+	Low                 decimal.Decimal
+	Close               decimal.Decimal
+	Volume              decimal.Decimal
+	CloseTime           time.Time
+	QuoteVolume         decimal.Decimal
+	NumberOfTrades      int
+	TakerBuyBaseVolume  decimal.Decimal
+	TakerBuyQuoteVolume decimal.Decimal
+}
+
+func (c *Candletick) UnmarshalJSON(data []byte) error {
+	var raw [12]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("binance: decode candlestick: %w", err)
+	}
+
+	openTime, err := unmarshalEpochMillis(raw[0])
+	if err != nil {
+		return fmt.Errorf("binance: decode candlestick open time: %w", err)
+	}
+
+	open, err := unmarshalDecimal(raw[1])
+	if err != nil {
+		return fmt.Errorf("binance: decode candlestick open: %w", err)
+	}
+
+	high, err := unmarshalDecimal(raw[2])
+	if err != nil {
+		return fmt.Errorf("binance: decode candlestick high: %w", err)
+	}
+
+	low, err := unmarshalDecimal(raw[3])
+	if err != nil {
+		return fmt.Errorf("binance: decode candlestick low: %w", err)
+	}
+
+	close, err := unmarshalDecimal(raw[4])
+	if err != nil {
+		return fmt.Errorf("binance: decode candlestick close: %w", err)
+	}
+
+	volume, err := unmarshalDecimal(raw[5])
+	if err != nil {
+		return fmt.Errorf("binance: decode candlestick volume: %w", err)
+	}
+
+	closeTime, err := unmarshalEpochMillis(raw[6])
+	if err != nil {
+		return fmt.Errorf("binance: decode candlestick close time: %w", err)
+	}
+
+	quoteVolume, err := unmarshalDecimal(raw[7])
+	if err != nil {
+		return fmt.Errorf("binance: decode candlestick quote volume: %w", err)
+	}
+
+	var numberOfTrades int
+	if err := json.Unmarshal(raw[8], &numberOfTrades); err != nil {
+		return fmt.Errorf("binance: decode candlestick number of trades: %w", err)
+	}
+
+	takerBuyBaseVolume, err := unmarshalDecimal(raw[9])
+	if err != nil {
+		return fmt.Errorf("binance: decode candlestick taker buy base volume: %w", err)
+	}
+
+	takerBuyQuoteVolume, err := unmarshalDecimal(raw[10])
+	if err != nil {
+		return fmt.Errorf("binance: decode candlestick taker buy quote volume: %w", err)
+	}
+
+	*c = Candletick{
+		OpenTime:            openTime,
+		Open:                open,
+		High:                high,
+		Low:                 low,
+		Close:               close,
+		Volume:              volume,
+		CloseTime:           closeTime,
+		QuoteVolume:         quoteVolume,
+		NumberOfTrades:      numberOfTrades,
+		TakerBuyBaseVolume:  takerBuyBaseVolume,
+		TakerBuyQuoteVolume: takerBuyQuoteVolume,
+	}
+
+	return nil
+}
+
+func (c Candletick) MarshalJSON() ([]byte, error) {
+	return json.Marshal([12]interface{}{
+		c.OpenTime.UnixMilli(),
+		c.Open.String(),
+		c.High.String(),
+		c.Low.String(),
+		c.Close.String(),
+		c.Volume.String(),
+		c.CloseTime.UnixMilli(),
+		c.QuoteVolume.String(),
+		c.NumberOfTrades,
+		c.TakerBuyBaseVolume.String(),
+		c.TakerBuyQuoteVolume.String(),
+		"0",
+	})
+}
+
+func unmarshalEpochMillis(raw json.RawMessage) (time.Time, error) {
+	var ms int64
+	if err := json.Unmarshal(raw, &ms); err != nil {
+		return time.Time{}, err
+	}
+
+	return time.UnixMilli(ms).UTC(), nil
+}
+
+func unmarshalDecimal(raw json.RawMessage) (decimal.Decimal, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	return decimal.NewFromString(s)
+}
@@ -0,0 +1,62 @@
+package binance
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// recorded from a live /fapi/v1/klines response.
+const recordedCandletick = `[1499040000000,"0.01634790","0.80000000","0.01575800","0.01577100","148976.11427815",1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","0"]`
+
+func TestCandletickUnmarshalJSON(t *testing.T) {
+	var c Candletick
+	if err := json.Unmarshal([]byte(recordedCandletick), &c); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+
+	if got, want := c.OpenTime, time.UnixMilli(1499040000000).UTC(); !got.Equal(want) {
+		t.Errorf("OpenTime = %v, want %v", got, want)
+	}
+	if got, want := c.CloseTime, time.UnixMilli(1499644799999).UTC(); !got.Equal(want) {
+		t.Errorf("CloseTime = %v, want %v", got, want)
+	}
+	if want, _ := decimal.NewFromString("0.01634790"); !c.Open.Equal(want) {
+		t.Errorf("Open = %s, want %s", c.Open, want)
+	}
+	if want, _ := decimal.NewFromString("148976.11427815"); !c.Volume.Equal(want) {
+		t.Errorf("Volume = %s, want %s", c.Volume, want)
+	}
+	if got, want := c.NumberOfTrades, 308; got != want {
+		t.Errorf("NumberOfTrades = %d, want %d", got, want)
+	}
+}
+
+func TestCandletickRoundTrip(t *testing.T) {
+	var want Candletick
+	if err := json.Unmarshal([]byte(recordedCandletick), &want); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+
+	var got Candletick
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("UnmarshalJSON() round-trip error = %v", err)
+	}
+
+	if !got.OpenTime.Equal(want.OpenTime) || !got.CloseTime.Equal(want.CloseTime) {
+		t.Errorf("round-trip times = %v/%v, want %v/%v", got.OpenTime, got.CloseTime, want.OpenTime, want.CloseTime)
+	}
+	if !got.Open.Equal(want.Open) || !got.Volume.Equal(want.Volume) {
+		t.Errorf("round-trip decimals = %v/%v, want %v/%v", got.Open, got.Volume, want.Open, want.Volume)
+	}
+	if got.NumberOfTrades != want.NumberOfTrades {
+		t.Errorf("round-trip NumberOfTrades = %d, want %d", got.NumberOfTrades, want.NumberOfTrades)
+	}
+}
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/json"
@@ -8,7 +9,6 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"os"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -30,7 +30,12 @@ const (
 	ORDER_TRADE_UPDATE EventType = "ORDER_TRADE_UPDATE"
 )
 
-const defaultBaseURL = "https://fapi.binance.com"
+const (
+	defaultBaseURL = "https://fapi.binance.com"
+	testnetBaseURL = "https://testnet.binancefuture.com"
+
+	defaultTimeout = 15 * time.Second
+)
 
 type NewOrderOptions struct {
 	Symbol      string `json:"symbol" url:"symbol"`
@@ -43,30 +48,124 @@ type NewOrderOptions struct {
 }
 
 type Client struct {
-	baseURL *url.URL
-	key     string
-	secret  []byte
+	baseURL    *url.URL
+	key        string
+	secret     []byte
+	logger     Logger
+	rl         *rateLimitState
+	httpClient *http.Client
+	recvWindow int64
+
+	// TradeService, AccountService and MarketDataService group the
+	// futures REST surface by concern; e.g.
+	// client.TradeService.NewCancelOrderRequest().Symbol("BTCUSDT").OrderID(123).Do(ctx)
+	TradeService      *TradeService
+	AccountService    *AccountService
+	MarketDataService *MarketDataService
 }
 
-func (c *Client) NewOrder(opt *NewOrderOptions) error {
+// ClientOptions configures a Client built with NewClientWithOptions.
+type ClientOptions struct {
+	Key    string
+	Secret string
+
+	// BaseURL overrides the default https://fapi.binance.com. Ignored if
+	// Testnet is true.
+	BaseURL string
+
+	// HTTPClient, if set, is used to perform requests instead of a
+	// default client with a 15s timeout.
+	HTTPClient *http.Client
+
+	// RecvWindow, if greater than zero, is sent as the recvWindow parameter on
+	// every signed request.
+	RecvWindow int64
+
+	// Testnet switches BaseURL to Binance's futures testnet.
+	Testnet bool
+}
+
+// OrderResponse is Binance's new order acknowledgement, returned by NewOrder.
+type OrderResponse struct {
+	OrderID       int64  `json:"orderId"`
+	Symbol        string `json:"symbol"`
+	Status        string `json:"status"`
+	ClientOrderID string `json:"clientOrderId"`
+	Price         string `json:"price"`
+	AvgPrice      string `json:"avgPrice"`
+	OrigQty       string `json:"origQty"`
+	ExecutedQty   string `json:"executedQty"`
+	Side          string `json:"side"`
+	Type          string `json:"type"`
+	TimeInForce   string `json:"timeInForce"`
+	ReduceOnly    bool   `json:"reduceOnly"`
+	UpdateTime    int64  `json:"updateTime"`
+}
+
+func (c *Client) NewOrder(opt *NewOrderOptions) (*OrderResponse, *http.Response, error) {
 	req, err := c.NewRequest("POST", "/fapi/v1/order", opt, TRADE)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	c.Do(req, nil)
+	v := new(OrderResponse)
+	resp, err := c.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
 
-	return nil
+	return v, resp, nil
 }
 
 func NewClient(key, secret string) *Client {
-	baseURL, _ := url.Parse(defaultBaseURL)
+	return NewClientWithOptions(ClientOptions{Key: key, Secret: secret})
+}
 
-	return &Client{
-		baseURL: baseURL,
-		key:     key,
-		secret:  []byte(secret),
+// NewClientWithOptions builds a Client from opts, letting callers override
+// the base URL (or switch to the testnet), supply their own *http.Client,
+// and set a default recvWindow for signed requests.
+func NewClientWithOptions(opts ClientOptions) *Client {
+	base := opts.BaseURL
+	switch {
+	case opts.Testnet:
+		base = testnetBaseURL
+	case base == "":
+		base = defaultBaseURL
 	}
+	baseURL, _ := url.Parse(base)
+
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+
+	c := &Client{
+		baseURL:    baseURL,
+		key:        opts.Key,
+		secret:     []byte(opts.Secret),
+		httpClient: httpClient,
+		recvWindow: opts.RecvWindow,
+		rl:         newRateLimitState(),
+	}
+
+	c.TradeService = &TradeService{client: c}
+	c.AccountService = &AccountService{client: c}
+	c.MarketDataService = &MarketDataService{client: c}
+
+	return c
+}
+
+// SetLogger installs l as the Client's diagnostic logger. By default a
+// Client logs nothing.
+func (c *Client) SetLogger(l Logger) {
+	c.logger = l
+}
+
+func (c *Client) log() Logger {
+	if c.logger != nil {
+		return c.logger
+	}
+	return noopLogger{}
 }
 
 func (c *Client) NewRequest(method, path string, opt interface{}, sec Security) (*http.Request, error) {
@@ -94,7 +193,21 @@ func (c *Client) NewRequest(method, path string, opt interface{}, sec Security)
 	return req, err
 }
 
+// NewRequestWithContext is NewRequest with ctx attached to the returned
+// request, so callers can cancel or time out in-flight requests.
+func (c *Client) NewRequestWithContext(ctx context.Context, method, path string, opt interface{}, sec Security) (*http.Request, error) {
+	req, err := c.NewRequest(method, path, opt, sec)
+	if err != nil {
+		return nil, err
+	}
+
+	return req.WithContext(ctx), nil
+}
+
 func (c *Client) sign(v url.Values) string {
+	if c.recvWindow > 0 {
+		v.Set("recvWindow", fmt.Sprintf("%d", c.recvWindow))
+	}
 	v.Set("timestamp", fmt.Sprintf("%d", time.Now().UnixNano()/int64(time.Millisecond)))
 
 	qs := v.Encode()
@@ -106,21 +219,95 @@ func (c *Client) sign(v url.Values) string {
 	return qs + "&" + s
 }
 
+// maxRateLimitRetries bounds how many times Do retries a single request
+// after a 429, so sustained rate-limiting fails loudly instead of
+// retrying forever.
+const maxRateLimitRetries = 3
+
+// Do sends req and decodes the response into v. If Binance responds with
+// any other HTTP 4xx/5xx status than 429 or 418, the body is decoded into
+// an *APIError and returned as the error instead; a body that is not
+// Binance's error envelope is returned as a plain error quoting it.
+//
+// Before sending, Do waits on the Client's rate limiter for the
+// endpoint's request weight, pre-emptively pacing requests instead of
+// relying solely on Binance to reject them. A 429 is retried up to
+// maxRateLimitRetries times, sleeping for the Retry-After duration between
+// attempts (aborting early if req's context is done); a 418 (IP ban) is
+// returned as a *BannedError, and later requests fail with one without
+// being sent until the ban lifts.
 func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
-	resp, err := http.DefaultClient.Do(req)
+	return c.do(req, v, 0)
+}
+
+func (c *Client) do(req *http.Request, v interface{}, attempt int) (*http.Response, error) {
+	rl := c.rateLimit()
+
+	if err := rl.checkBan(); err != nil {
+		return nil, err
+	}
+
+	if err := rl.limiter.Load().WaitN(req.Context(), endpointWeight(req.Method, req.URL.Path)); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return resp, err
 	}
 	defer resp.Body.Close()
 
-	r := io.TeeReader(resp.Body, os.Stderr)
+	rl.recordUsage(resp.Header)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if attempt >= maxRateLimitRetries {
+			return resp, fmt.Errorf("binance: rate limited after %d retries", attempt)
+		}
+
+		wait := retryAfter(resp.Header)
+		c.log().Printf("binance: rate limited, retrying in %s (attempt %d/%d)", wait, attempt+1, maxRateLimitRetries)
+
+		select {
+		case <-req.Context().Done():
+			return resp, req.Context().Err()
+		case <-time.After(wait):
+		}
+
+		return c.do(req, v, attempt+1)
+	}
+
+	if resp.StatusCode == http.StatusTeapot {
+		rl.mu.Lock()
+		rl.bannedUntil = time.Now().Add(retryAfter(resp.Header))
+		until := rl.bannedUntil
+		rl.mu.Unlock()
+
+		return resp, &BannedError{Until: until}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := new(APIError)
+		if jsonErr := json.Unmarshal(body, apiErr); jsonErr != nil {
+			return resp, fmt.Errorf("binance: http %d: %s", resp.StatusCode, body)
+		}
+		apiErr.HTTPStatus = resp.StatusCode
+		c.log().Printf("binance: %s", apiErr)
+
+		return resp, apiErr
+	}
 
 	if v != nil {
-		err = json.NewDecoder(r).Decode(&v)
+		if err := json.Unmarshal(body, v); err != nil {
+			return resp, err
+		}
 	}
-	fmt.Fprintln(os.Stderr)
 
-	return resp, err
+	return resp, nil
 }
 
 type UserDataStream struct {
@@ -161,16 +348,6 @@ func (c *Client) CloseUserDataStream() (*http.Response, error) {
 	return resp, err
 }
 
-type Candletick struct {
-	OpenTime  int `json:"0"`
-	Open      float64
-	High      float64
-	Low       float64
-	Close     float64
-	Volume    float64
-	CloseTime int
-}
-
 type CandlestickDataOptions struct {
 	Symbol    string `url:"symbol"`
 	Interval  string `url:"interval"`
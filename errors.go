@@ -0,0 +1,26 @@
+package binance
+
+import "fmt"
+
+// APIError represents Binance's {"code":-2010,"msg":"..."} error envelope,
+// returned when a request fails with an HTTP 4xx/5xx status other than
+// 429 or 418 and the response body holds that envelope.
+type APIError struct {
+	Code       int    `json:"code"`
+	Msg        string `json:"msg"`
+	HTTPStatus int    `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("binance: %s (code %d, http status %d)", e.Msg, e.Code, e.HTTPStatus)
+}
+
+// Logger is the logging interface Client accepts for diagnostic output. It
+// is satisfied by *log.Logger. The zero-value Client logs nothing.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Printf(string, ...interface{}) {}
@@ -0,0 +1,254 @@
+package binance
+
+import "context"
+
+// MarketDataService groups the public market-data endpoints of the USD-M
+// futures API. Its requests carry the API key header but are not signed.
+type MarketDataService struct {
+	client *Client
+}
+
+// ExchangeInfo describes trading rules and symbol metadata.
+type ExchangeInfo struct {
+	Timezone   string `json:"timezone"`
+	ServerTime int64  `json:"serverTime"`
+	Symbols    []struct {
+		Symbol     string `json:"symbol"`
+		Status     string `json:"status"`
+		BaseAsset  string `json:"baseAsset"`
+		QuoteAsset string `json:"quoteAsset"`
+	} `json:"symbols"`
+}
+
+// ExchangeInfoRequest fetches trading rules and symbol metadata.
+type ExchangeInfoRequest struct {
+	client *Client
+}
+
+func (s *MarketDataService) NewExchangeInfoRequest() *ExchangeInfoRequest {
+	return &ExchangeInfoRequest{client: s.client}
+}
+
+func (r *ExchangeInfoRequest) Do(ctx context.Context) (*ExchangeInfo, error) {
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v1/exchangeInfo", nil, MARKET_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(ExchangeInfo)
+	if _, err := r.client.Do(req, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Depth is an order book snapshot.
+type Depth struct {
+	LastUpdateID int64      `json:"lastUpdateId"`
+	Bids         [][]string `json:"bids"`
+	Asks         [][]string `json:"asks"`
+}
+
+// DepthRequest fetches an order book snapshot for a symbol.
+type DepthRequest struct {
+	client *Client
+	symbol string
+	limit  int
+}
+
+func (s *MarketDataService) NewDepthRequest() *DepthRequest {
+	return &DepthRequest{client: s.client}
+}
+
+func (r *DepthRequest) Symbol(symbol string) *DepthRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *DepthRequest) Limit(limit int) *DepthRequest {
+	r.limit = limit
+	return r
+}
+
+func (r *DepthRequest) Do(ctx context.Context) (*Depth, error) {
+	opt := &struct {
+		Symbol string `url:"symbol"`
+		Limit  int    `url:"limit,omitempty"`
+	}{Symbol: r.symbol, Limit: r.limit}
+
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v1/depth", opt, MARKET_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(Depth)
+	if _, err := r.client.Do(req, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Ticker24hr is a rolling 24 hour price change statistics snapshot.
+type Ticker24hr struct {
+	Symbol             string `json:"symbol"`
+	PriceChange        string `json:"priceChange"`
+	PriceChangePercent string `json:"priceChangePercent"`
+	LastPrice          string `json:"lastPrice"`
+	Volume             string `json:"volume"`
+	QuoteVolume        string `json:"quoteVolume"`
+	OpenTime           int64  `json:"openTime"`
+	CloseTime          int64  `json:"closeTime"`
+}
+
+// Ticker24hrRequest fetches 24hr ticker statistics, optionally for a
+// single symbol; with no symbol set, Binance returns every symbol.
+type Ticker24hrRequest struct {
+	client *Client
+	symbol string
+}
+
+func (s *MarketDataService) NewTicker24hrRequest() *Ticker24hrRequest {
+	return &Ticker24hrRequest{client: s.client}
+}
+
+func (r *Ticker24hrRequest) Symbol(symbol string) *Ticker24hrRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *Ticker24hrRequest) Do(ctx context.Context) ([]*Ticker24hr, error) {
+	opt := &struct {
+		Symbol string `url:"symbol,omitempty"`
+	}{Symbol: r.symbol}
+
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v1/ticker/24hr", opt, MARKET_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.symbol != "" {
+		v := new(Ticker24hr)
+		if _, err := r.client.Do(req, v); err != nil {
+			return nil, err
+		}
+		return []*Ticker24hr{v}, nil
+	}
+
+	var v []*Ticker24hr
+	if _, err := r.client.Do(req, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// PremiumIndex is a symbol's mark price, index price and current funding rate.
+type PremiumIndex struct {
+	Symbol          string `json:"symbol"`
+	MarkPrice       string `json:"markPrice"`
+	IndexPrice      string `json:"indexPrice"`
+	LastFundingRate string `json:"lastFundingRate"`
+	NextFundingTime int64  `json:"nextFundingTime"`
+	Time            int64  `json:"time"`
+}
+
+// PremiumIndexRequest fetches the mark price and funding rate for a symbol.
+type PremiumIndexRequest struct {
+	client *Client
+	symbol string
+}
+
+func (s *MarketDataService) NewPremiumIndexRequest() *PremiumIndexRequest {
+	return &PremiumIndexRequest{client: s.client}
+}
+
+func (r *PremiumIndexRequest) Symbol(symbol string) *PremiumIndexRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *PremiumIndexRequest) Do(ctx context.Context) (*PremiumIndex, error) {
+	opt := &struct {
+		Symbol string `url:"symbol"`
+	}{Symbol: r.symbol}
+
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v1/premiumIndex", opt, MARKET_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(PremiumIndex)
+	if _, err := r.client.Do(req, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// FundingRate is one historical funding rate settlement.
+type FundingRate struct {
+	Symbol      string `json:"symbol"`
+	FundingRate string `json:"fundingRate"`
+	FundingTime int64  `json:"fundingTime"`
+}
+
+// FundingRateRequest fetches historical funding rate settlements for a symbol.
+type FundingRateRequest struct {
+	client    *Client
+	symbol    string
+	startTime int64
+	endTime   int64
+	limit     int
+}
+
+func (s *MarketDataService) NewFundingRateRequest() *FundingRateRequest {
+	return &FundingRateRequest{client: s.client}
+}
+
+func (r *FundingRateRequest) Symbol(symbol string) *FundingRateRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *FundingRateRequest) StartTime(startTime int64) *FundingRateRequest {
+	r.startTime = startTime
+	return r
+}
+
+func (r *FundingRateRequest) EndTime(endTime int64) *FundingRateRequest {
+	r.endTime = endTime
+	return r
+}
+
+func (r *FundingRateRequest) Limit(limit int) *FundingRateRequest {
+	r.limit = limit
+	return r
+}
+
+func (r *FundingRateRequest) Do(ctx context.Context) ([]*FundingRate, error) {
+	opt := &struct {
+		Symbol    string `url:"symbol,omitempty"`
+		StartTime int64  `url:"startTime,omitempty"`
+		EndTime   int64  `url:"endTime,omitempty"`
+		Limit     int    `url:"limit,omitempty"`
+	}{
+		Symbol:    r.symbol,
+		StartTime: r.startTime,
+		EndTime:   r.endTime,
+		Limit:     r.limit,
+	}
+
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v1/fundingRate", opt, MARKET_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	var v []*FundingRate
+	if _, err := r.client.Do(req, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
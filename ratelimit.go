@@ -0,0 +1,148 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// endpointWeights maps "METHOD /path" to the request weight Binance
+// assigns it, so outgoing requests can be throttled before Binance itself
+// would reject them. Endpoints not listed here use defaultEndpointWeight.
+var endpointWeights = map[string]int{
+	"POST /fapi/v1/order":           1,
+	"DELETE /fapi/v1/order":         1,
+	"GET /fapi/v1/order":            1,
+	"DELETE /fapi/v1/allOpenOrders": 1,
+	"GET /fapi/v1/openOrders":       40, // 1 with symbol, 40 without; pace for the worst case
+	"GET /fapi/v1/allOrders":        5,
+	"POST /fapi/v1/batchOrders":     5,
+	"GET /fapi/v1/klines":           5,
+	"POST /fapi/v1/listenKey":       1,
+	"PUT /fapi/v1/listenKey":        1,
+	"DELETE /fapi/v1/listenKey":     1,
+	"GET /fapi/v2/positionRisk":     5,
+	"GET /fapi/v2/balance":          5,
+	"GET /fapi/v2/account":          5,
+	"POST /fapi/v1/leverage":        1,
+	"POST /fapi/v1/marginType":      1,
+	"GET /fapi/v1/exchangeInfo":     1,
+	"GET /fapi/v1/depth":            20, // 2-20 depending on limit; pace for the worst case
+	"GET /fapi/v1/ticker/24hr":      40, // 1 with symbol, 40 without; pace for the worst case
+	"GET /fapi/v1/premiumIndex":     1,
+	"GET /fapi/v1/fundingRate":      1,
+}
+
+const defaultEndpointWeight = 1
+
+// defaultWeightPerMinute mirrors Binance's default USD-M futures limit of
+// 2400 request weight per minute.
+const defaultWeightPerMinute = 2400
+
+func endpointWeight(method, path string) int {
+	if w, ok := endpointWeights[method+" "+path]; ok {
+		return w
+	}
+	return defaultEndpointWeight
+}
+
+// rateLimitState tracks the rate limiter used to pace outgoing requests
+// and the weight/order-count usage Binance reports back on every response.
+type rateLimitState struct {
+	// limiter is swapped out wholesale by SetRateLimit while Do reads it
+	// concurrently on every request, so it's held behind an atomic
+	// pointer rather than rl.mu to keep the hot WaitN path lock-free.
+	limiter atomic.Pointer[rate.Limiter]
+
+	mu          sync.Mutex
+	usedWeight  int
+	orderCounts map[string]int
+	bannedUntil time.Time
+}
+
+func newRateLimitState() *rateLimitState {
+	rl := &rateLimitState{}
+	rl.limiter.Store(rate.NewLimiter(rate.Limit(defaultWeightPerMinute)/60, defaultWeightPerMinute))
+	return rl
+}
+
+// SetRateLimit reconfigures the pre-emptive rate limiter to weightPerMinute
+// request weight per minute, with burst allowed up to the same amount.
+func (c *Client) SetRateLimit(weightPerMinute int) {
+	c.rateLimit().limiter.Store(rate.NewLimiter(rate.Limit(weightPerMinute)/60, weightPerMinute))
+}
+
+// UsedWeight returns the most recently reported X-MBX-USED-WEIGHT-1m value.
+func (c *Client) UsedWeight() int {
+	rl := c.rateLimit()
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return rl.usedWeight
+}
+
+// rateLimit returns the Client's rateLimitState. It is always set by
+// NewClientWithOptions; it is not initialized lazily here because Do is
+// called concurrently and a lazy check-then-set would race.
+func (c *Client) rateLimit() *rateLimitState {
+	return c.rl
+}
+
+// BannedError is returned without making a request once Binance has
+// responded 418 and asked us to back off until a future time.
+type BannedError struct {
+	Until time.Time
+}
+
+func (e *BannedError) Error() string {
+	return fmt.Sprintf("binance: IP banned until %s", e.Until.Format(time.RFC3339))
+}
+
+func (rl *rateLimitState) checkBan() error {
+	rl.mu.Lock()
+	until := rl.bannedUntil
+	rl.mu.Unlock()
+
+	if time.Now().Before(until) {
+		return &BannedError{Until: until}
+	}
+	return nil
+}
+
+func (rl *rateLimitState) recordUsage(header http.Header) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	for name, values := range header {
+		if len(values) == 0 {
+			continue
+		}
+
+		switch {
+		case strings.EqualFold(name, "X-Mbx-Used-Weight-1m"):
+			if w, err := strconv.Atoi(values[0]); err == nil {
+				rl.usedWeight = w
+			}
+		case strings.HasPrefix(strings.ToUpper(name), "X-MBX-ORDER-COUNT-"):
+			if n, err := strconv.Atoi(values[0]); err == nil {
+				if rl.orderCounts == nil {
+					rl.orderCounts = make(map[string]int)
+				}
+				rl.orderCounts[name] = n
+			}
+		}
+	}
+}
+
+func retryAfter(header http.Header) time.Duration {
+	secs, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || secs <= 0 {
+		return time.Second
+	}
+	return time.Duration(secs) * time.Second
+}
@@ -0,0 +1,343 @@
+// Package stream implements the USD-M futures user data websocket stream,
+// layered on top of the listenKey REST endpoints exposed by *binance.Client.
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/xkitpro/binance"
+)
+
+const (
+	streamBaseURL  = "wss://fstream.binance.com/ws/"
+	keepAliveEvery = 30 * time.Minute
+	reconnectDelay = 5 * time.Second
+)
+
+// ListenKeyProvider is the subset of *binance.Client the stream needs in
+// order to obtain and refresh a user data stream listenKey.
+type ListenKeyProvider interface {
+	StartUserDataStream() (*binance.UserDataStream, *http.Response, error)
+	KeepAliveUserDataStream() (*http.Response, error)
+	CloseUserDataStream() (*http.Response, error)
+}
+
+type rawEvent struct {
+	EventType string `json:"e"`
+}
+
+// OrderTradeUpdate is sent whenever an order is created, filled, cancelled
+// or otherwise changes state.
+type OrderTradeUpdate struct {
+	EventType       string `json:"e"`
+	EventTime       int64  `json:"E"`
+	TransactionTime int64  `json:"T"`
+	Order           struct {
+		Symbol        string `json:"s"`
+		ClientOrderID string `json:"c"`
+		Side          string `json:"S"`
+		Type          string `json:"o"`
+		TimeInForce   string `json:"f"`
+		Quantity      string `json:"q"`
+		Price         string `json:"p"`
+		Status        string `json:"X"`
+		OrderID       int64  `json:"i"`
+		LastFilledQty string `json:"l"`
+		FilledQty     string `json:"z"`
+		LastFillPrice string `json:"L"`
+	} `json:"o"`
+}
+
+// AccountUpdate is sent whenever balances or positions change.
+type AccountUpdate struct {
+	EventType string `json:"e"`
+	EventTime int64  `json:"E"`
+	Account   struct {
+		Reason   string `json:"m"`
+		Balances []struct {
+			Asset              string `json:"a"`
+			WalletBalance      string `json:"wb"`
+			CrossWalletBalance string `json:"cw"`
+		} `json:"B"`
+		Positions []struct {
+			Symbol       string `json:"s"`
+			Amount       string `json:"pa"`
+			EntryPrice   string `json:"ep"`
+			UnrealizedPL string `json:"up"`
+		} `json:"P"`
+	} `json:"a"`
+}
+
+// MarginCall is sent when a position's margin ratio crosses the liquidation
+// threshold.
+type MarginCall struct {
+	EventType          string `json:"e"`
+	EventTime          int64  `json:"E"`
+	CrossWalletBalance string `json:"cw"`
+	Positions          []struct {
+		Symbol         string `json:"s"`
+		Side           string `json:"ps"`
+		Amount         string `json:"pa"`
+		MarginType     string `json:"mt"`
+		IsolatedWallet string `json:"iw"`
+		MarkPrice      string `json:"mp"`
+		UnrealizedPL   string `json:"up"`
+		MaintMargin    string `json:"mm"`
+	} `json:"p"`
+}
+
+// UserDataStream dials the futures user data websocket, dispatches typed
+// events to registered callbacks and transparently re-obtains a listenKey
+// and reconnects on disconnect or listenKeyExpired.
+//
+// It mirrors the REST/stream split used by bbgo's exchange clients: the
+// REST side (ListenKeyProvider) only knows about listenKeys, the stream
+// side owns the websocket connection and event dispatch.
+type UserDataStream struct {
+	client ListenKeyProvider
+	logger *log.Logger
+
+	mu        sync.Mutex
+	conn      *websocket.Conn
+	listenKey string
+	cancel    context.CancelFunc
+
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	onOrderTradeUpdate []func(*OrderTradeUpdate)
+	onAccountUpdate    []func(*AccountUpdate)
+	onMarginCall       []func(*MarginCall)
+	onListenKeyExpired []func()
+}
+
+// NewUserDataStream builds a UserDataStream backed by client. Connect must
+// be called before any events are delivered.
+func NewUserDataStream(client ListenKeyProvider) *UserDataStream {
+	return &UserDataStream{
+		client: client,
+		logger: log.Default(),
+		closed: make(chan struct{}),
+	}
+}
+
+// OnOrderTradeUpdate registers a callback invoked for ORDER_TRADE_UPDATE events.
+func (s *UserDataStream) OnOrderTradeUpdate(fn func(*OrderTradeUpdate)) {
+	s.onOrderTradeUpdate = append(s.onOrderTradeUpdate, fn)
+}
+
+// OnAccountUpdate registers a callback invoked for ACCOUNT_UPDATE events.
+func (s *UserDataStream) OnAccountUpdate(fn func(*AccountUpdate)) {
+	s.onAccountUpdate = append(s.onAccountUpdate, fn)
+}
+
+// OnMarginCall registers a callback invoked for MARGIN_CALL events.
+func (s *UserDataStream) OnMarginCall(fn func(*MarginCall)) {
+	s.onMarginCall = append(s.onMarginCall, fn)
+}
+
+// OnListenKeyExpired registers a callback invoked when Binance expires the
+// listenKey out from under us, just before the stream reconnects with a
+// fresh one.
+func (s *UserDataStream) OnListenKeyExpired(fn func()) {
+	s.onListenKeyExpired = append(s.onListenKeyExpired, fn)
+}
+
+// Connect obtains a listenKey, dials the websocket and starts the
+// background read and keepalive loops. It returns once the initial
+// listenKey has been obtained; the connection itself is established
+// asynchronously and reconnects on failure until ctx is done or Close is
+// called.
+func (s *UserDataStream) Connect(ctx context.Context) error {
+	if err := s.refreshListenKey(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	s.mu.Lock()
+	s.cancel = cancel
+	s.mu.Unlock()
+
+	go s.run(ctx)
+	go s.keepAlive(ctx)
+
+	return nil
+}
+
+// Close tears down the stream: it cancels the context Connect's background
+// loops run under (aborting any in-flight dial), closes the websocket
+// connection and releases the listenKey on Binance's side.
+func (s *UserDataStream) Close() error {
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		close(s.closed)
+		conn := s.conn
+		cancel := s.cancel
+		s.mu.Unlock()
+
+		if cancel != nil {
+			cancel()
+		}
+
+		if conn != nil {
+			conn.Close()
+		}
+	})
+
+	_, err := s.client.CloseUserDataStream()
+	return err
+}
+
+func (s *UserDataStream) refreshListenKey() error {
+	key, _, err := s.client.StartUserDataStream()
+	if err != nil {
+		return fmt.Errorf("stream: start user data stream: %w", err)
+	}
+
+	s.mu.Lock()
+	s.listenKey = key.ListenKey
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *UserDataStream) run(ctx context.Context) {
+	for {
+		if err := s.dialAndRead(ctx); err != nil {
+			s.logger.Printf("stream: connection lost: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.closed:
+			return
+		case <-time.After(reconnectDelay):
+		}
+
+		if err := s.refreshListenKey(); err != nil {
+			s.logger.Printf("stream: reconnect: %v", err)
+		}
+	}
+}
+
+func (s *UserDataStream) dialAndRead(ctx context.Context) error {
+	s.mu.Lock()
+	key := s.listenKey
+	s.mu.Unlock()
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, streamBaseURL+key, nil)
+	if err != nil {
+		return err
+	}
+
+	// Close may have run while the dial was in flight; a context
+	// cancellation doesn't retroactively undo a dial that already
+	// succeeded. The closed-check and the conn assignment must happen
+	// under the same lock Close() uses around closing s.closed and
+	// reading s.conn, or the two can interleave and orphan this
+	// connection instead of closing it.
+	s.mu.Lock()
+	select {
+	case <-s.closed:
+		s.mu.Unlock()
+		conn.Close()
+		return fmt.Errorf("stream: closed during dial")
+	default:
+	}
+	s.conn = conn
+	s.mu.Unlock()
+
+	defer conn.Close()
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		if s.dispatch(data) {
+			return fmt.Errorf("stream: listenKey expired")
+		}
+	}
+}
+
+// dispatch decodes a raw event and invokes the matching callbacks. It
+// reports whether the event was listenKeyExpired, which forces a
+// reconnect with a fresh listenKey.
+func (s *UserDataStream) dispatch(data []byte) (expired bool) {
+	var raw rawEvent
+	if err := json.Unmarshal(data, &raw); err != nil {
+		s.logger.Printf("stream: decode event: %v", err)
+		return false
+	}
+
+	switch binance.EventType(raw.EventType) {
+	case binance.ORDER_TRADE_UPDATE:
+		var evt OrderTradeUpdate
+		if err := json.Unmarshal(data, &evt); err != nil {
+			s.logger.Printf("stream: decode order trade update: %v", err)
+			return false
+		}
+		for _, fn := range s.onOrderTradeUpdate {
+			fn(&evt)
+		}
+	case accountUpdateEvent:
+		var evt AccountUpdate
+		if err := json.Unmarshal(data, &evt); err != nil {
+			s.logger.Printf("stream: decode account update: %v", err)
+			return false
+		}
+		for _, fn := range s.onAccountUpdate {
+			fn(&evt)
+		}
+	case marginCallEvent:
+		var evt MarginCall
+		if err := json.Unmarshal(data, &evt); err != nil {
+			s.logger.Printf("stream: decode margin call: %v", err)
+			return false
+		}
+		for _, fn := range s.onMarginCall {
+			fn(&evt)
+		}
+	case listenKeyExpiredEvent:
+		for _, fn := range s.onListenKeyExpired {
+			fn()
+		}
+		return true
+	}
+
+	return false
+}
+
+const (
+	accountUpdateEvent    binance.EventType = "ACCOUNT_UPDATE"
+	marginCallEvent       binance.EventType = "MARGIN_CALL"
+	listenKeyExpiredEvent binance.EventType = "listenKeyExpired"
+)
+
+func (s *UserDataStream) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(keepAliveEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.closed:
+			return
+		case <-ticker.C:
+			if _, err := s.client.KeepAliveUserDataStream(); err != nil {
+				s.logger.Printf("stream: keepalive: %v", err)
+			}
+		}
+	}
+}
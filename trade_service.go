@@ -0,0 +1,326 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TradeService groups the order-management endpoints of the USD-M futures
+// API, mirroring the REST/account/trade service split used elsewhere in
+// the ecosystem (e.g. bbgo's kucoin/okex clients).
+type TradeService struct {
+	client *Client
+}
+
+// CancelOrderRequest cancels a single open order.
+type CancelOrderRequest struct {
+	client            *Client
+	symbol            string
+	orderID           int64
+	origClientOrderID string
+}
+
+func (s *TradeService) NewCancelOrderRequest() *CancelOrderRequest {
+	return &CancelOrderRequest{client: s.client}
+}
+
+func (r *CancelOrderRequest) Symbol(symbol string) *CancelOrderRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *CancelOrderRequest) OrderID(orderID int64) *CancelOrderRequest {
+	r.orderID = orderID
+	return r
+}
+
+func (r *CancelOrderRequest) OrigClientOrderID(id string) *CancelOrderRequest {
+	r.origClientOrderID = id
+	return r
+}
+
+func (r *CancelOrderRequest) Do(ctx context.Context) (*OrderResponse, error) {
+	opt := &struct {
+		Symbol            string `url:"symbol"`
+		OrderID           int64  `url:"orderId,omitempty"`
+		OrigClientOrderID string `url:"origClientOrderId,omitempty"`
+	}{
+		Symbol:            r.symbol,
+		OrderID:           r.orderID,
+		OrigClientOrderID: r.origClientOrderID,
+	}
+
+	req, err := r.client.NewRequestWithContext(ctx, "DELETE", "/fapi/v1/order", opt, TRADE)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(OrderResponse)
+	if _, err := r.client.Do(req, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// CancelAllOpenOrdersRequest cancels every open order on a symbol.
+type CancelAllOpenOrdersRequest struct {
+	client *Client
+	symbol string
+}
+
+func (s *TradeService) NewCancelAllOpenOrdersRequest() *CancelAllOpenOrdersRequest {
+	return &CancelAllOpenOrdersRequest{client: s.client}
+}
+
+func (r *CancelAllOpenOrdersRequest) Symbol(symbol string) *CancelAllOpenOrdersRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *CancelAllOpenOrdersRequest) Do(ctx context.Context) (*http.Response, error) {
+	opt := &struct {
+		Symbol string `url:"symbol"`
+	}{Symbol: r.symbol}
+
+	req, err := r.client.NewRequestWithContext(ctx, "DELETE", "/fapi/v1/allOpenOrders", opt, TRADE)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.Do(req, nil)
+}
+
+// QueryOrderRequest looks up a single order, open or historical.
+type QueryOrderRequest struct {
+	client            *Client
+	symbol            string
+	orderID           int64
+	origClientOrderID string
+}
+
+func (s *TradeService) NewQueryOrderRequest() *QueryOrderRequest {
+	return &QueryOrderRequest{client: s.client}
+}
+
+func (r *QueryOrderRequest) Symbol(symbol string) *QueryOrderRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *QueryOrderRequest) OrderID(orderID int64) *QueryOrderRequest {
+	r.orderID = orderID
+	return r
+}
+
+func (r *QueryOrderRequest) OrigClientOrderID(id string) *QueryOrderRequest {
+	r.origClientOrderID = id
+	return r
+}
+
+func (r *QueryOrderRequest) Do(ctx context.Context) (*OrderResponse, error) {
+	opt := &struct {
+		Symbol            string `url:"symbol"`
+		OrderID           int64  `url:"orderId,omitempty"`
+		OrigClientOrderID string `url:"origClientOrderId,omitempty"`
+	}{
+		Symbol:            r.symbol,
+		OrderID:           r.orderID,
+		OrigClientOrderID: r.origClientOrderID,
+	}
+
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v1/order", opt, USER_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(OrderResponse)
+	if _, err := r.client.Do(req, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// GetOpenOrdersRequest lists currently open orders, optionally filtered to
+// a single symbol.
+type GetOpenOrdersRequest struct {
+	client *Client
+	symbol string
+}
+
+func (s *TradeService) NewGetOpenOrdersRequest() *GetOpenOrdersRequest {
+	return &GetOpenOrdersRequest{client: s.client}
+}
+
+func (r *GetOpenOrdersRequest) Symbol(symbol string) *GetOpenOrdersRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *GetOpenOrdersRequest) Do(ctx context.Context) ([]*OrderResponse, error) {
+	opt := &struct {
+		Symbol string `url:"symbol,omitempty"`
+	}{Symbol: r.symbol}
+
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v1/openOrders", opt, USER_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	var v []*OrderResponse
+	if _, err := r.client.Do(req, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// GetAllOrdersRequest lists all orders (open, filled or cancelled) for a
+// symbol, optionally starting from orderID or a time range.
+type GetAllOrdersRequest struct {
+	client    *Client
+	symbol    string
+	orderID   int64
+	startTime int64
+	endTime   int64
+	limit     int
+}
+
+func (s *TradeService) NewGetAllOrdersRequest() *GetAllOrdersRequest {
+	return &GetAllOrdersRequest{client: s.client}
+}
+
+func (r *GetAllOrdersRequest) Symbol(symbol string) *GetAllOrdersRequest {
+	r.symbol = symbol
+	return r
+}
+
+func (r *GetAllOrdersRequest) OrderID(orderID int64) *GetAllOrdersRequest {
+	r.orderID = orderID
+	return r
+}
+
+func (r *GetAllOrdersRequest) StartTime(startTime int64) *GetAllOrdersRequest {
+	r.startTime = startTime
+	return r
+}
+
+func (r *GetAllOrdersRequest) EndTime(endTime int64) *GetAllOrdersRequest {
+	r.endTime = endTime
+	return r
+}
+
+func (r *GetAllOrdersRequest) Limit(limit int) *GetAllOrdersRequest {
+	r.limit = limit
+	return r
+}
+
+func (r *GetAllOrdersRequest) Do(ctx context.Context) ([]*OrderResponse, error) {
+	opt := &struct {
+		Symbol    string `url:"symbol"`
+		OrderID   int64  `url:"orderId,omitempty"`
+		StartTime int64  `url:"startTime,omitempty"`
+		EndTime   int64  `url:"endTime,omitempty"`
+		Limit     int    `url:"limit,omitempty"`
+	}{
+		Symbol:    r.symbol,
+		OrderID:   r.orderID,
+		StartTime: r.startTime,
+		EndTime:   r.endTime,
+		Limit:     r.limit,
+	}
+
+	req, err := r.client.NewRequestWithContext(ctx, "GET", "/fapi/v1/allOrders", opt, USER_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	var v []*OrderResponse
+	if _, err := r.client.Do(req, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// BatchOrdersRequest places up to 5 orders in a single call to
+// POST /fapi/v1/batchOrders.
+type BatchOrdersRequest struct {
+	client *Client
+	orders []*NewOrderOptions
+}
+
+func (s *TradeService) NewBatchOrdersRequest() *BatchOrdersRequest {
+	return &BatchOrdersRequest{client: s.client}
+}
+
+func (r *BatchOrdersRequest) AddOrder(opt *NewOrderOptions) *BatchOrdersRequest {
+	r.orders = append(r.orders, opt)
+	return r
+}
+
+// BatchOrderResult is one entry of a batch order response. Binance places
+// each order independently, so a batch can partially fail: an entry with
+// Err set means that particular order was rejected, while its siblings in
+// the same batch may still have Order set.
+type BatchOrderResult struct {
+	Order *OrderResponse
+	Err   *APIError
+}
+
+func (r *BatchOrderResult) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Code int `json:"code"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return fmt.Errorf("binance: decode batch order result: %w", err)
+	}
+
+	// Binance's error entries carry a non-zero "code"; order acks don't.
+	if probe.Code != 0 {
+		apiErr := new(APIError)
+		if err := json.Unmarshal(data, apiErr); err != nil {
+			return fmt.Errorf("binance: decode batch order error: %w", err)
+		}
+		r.Err = apiErr
+		return nil
+	}
+
+	order := new(OrderResponse)
+	if err := json.Unmarshal(data, order); err != nil {
+		return fmt.Errorf("binance: decode batch order: %w", err)
+	}
+	r.Order = order
+
+	return nil
+}
+
+func (r *BatchOrdersRequest) Do(ctx context.Context) ([]*BatchOrderResult, error) {
+	if len(r.orders) == 0 || len(r.orders) > 5 {
+		return nil, fmt.Errorf("binance: batch orders must contain between 1 and 5 orders, got %d", len(r.orders))
+	}
+
+	batch, err := json.Marshal(r.orders)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &struct {
+		BatchOrders string `url:"batchOrders"`
+	}{BatchOrders: string(batch)}
+
+	req, err := r.client.NewRequestWithContext(ctx, "POST", "/fapi/v1/batchOrders", opt, TRADE)
+	if err != nil {
+		return nil, err
+	}
+
+	var v []*BatchOrderResult
+	if _, err := r.client.Do(req, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}